@@ -0,0 +1,98 @@
+// Command array_processing is the Go baseline for tova_array_processing.tova.
+// It lives in its own module-rooted package (rather than
+// benchmarks/go/05_array_processing.go) so it can import benchmarks/driver:
+// the rest of benchmarks/go is a directory of standalone, single-file
+// `go run`-able mains that can't share an import graph with each other,
+// since Go requires one package per directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+
+	"github.com/tova-lang/tova-lang/benchmarks/driver"
+)
+
+func benchmarkMapFilterReduce(opts *driver.Options, size int) {
+	data := make([]int, size)
+	for i := 0; i < size; i++ {
+		data[i] = i
+	}
+
+	var result int
+	r := driver.Run("array_processing.map_filter_reduce", opts, func() {
+		filtered := make([]int, 0, size)
+		for _, x := range data {
+			if x%3 != 0 {
+				filtered = append(filtered, x)
+			}
+		}
+
+		mapped := make([]int, len(filtered))
+		for i, x := range filtered {
+			mapped[i] = x * x
+		}
+
+		result = 0
+		for _, x := range mapped {
+			result += x
+		}
+	})
+	r.Params = map[string]string{"size": fmt.Sprintf("%d", size)}
+	r.ResultChecksum = fmt.Sprintf("result=%d", result)
+	r.Emit(opts)
+}
+
+func benchmarkSort(opts *driver.Options, size int) {
+	data := make([]int, size)
+	for i := 0; i < size; i++ {
+		data[i] = size - i
+	}
+
+	buf := make([]int, size)
+	r := driver.Run("array_processing.sort", opts, func() {
+		copy(buf, data)
+		sort.Ints(buf)
+	})
+	r.Params = map[string]string{"size": fmt.Sprintf("%d", size)}
+	r.ResultChecksum = fmt.Sprintf("first=%d last=%d", buf[0], buf[size-1])
+	r.Emit(opts)
+}
+
+func benchmarkFind(opts *driver.Options, size int) {
+	data := make([]int, size)
+	for i := 0; i < size; i++ {
+		data[i] = i
+	}
+	target := size - 1
+
+	var found int
+	r := driver.Run("array_processing.find_x100", opts, func() {
+		found = 0
+		for i := 0; i < 100; i++ {
+			for _, x := range data {
+				if x == target {
+					found++
+					break
+				}
+			}
+		}
+	})
+	r.Params = map[string]string{"size": fmt.Sprintf("%d", size)}
+	r.ResultChecksum = fmt.Sprintf("found=%d", found)
+	r.Emit(opts)
+}
+
+func main() {
+	opts := driver.Flags()
+	flag.Parse()
+	opts.ResolveFormat()
+
+	benchmarkMapFilterReduce(opts, 100000)
+	benchmarkMapFilterReduce(opts, 1000000)
+	benchmarkSort(opts, 100000)
+	benchmarkSort(opts, 1000000)
+	benchmarkFind(opts, 100000)
+	benchmarkFind(opts, 1000000)
+}
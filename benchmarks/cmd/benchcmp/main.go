@@ -0,0 +1,102 @@
+// Command benchcmp ingests two JSONL result files produced by
+// `driver.Result.JSONLine` (e.g. one per runtime, or a baseline vs a PR's
+// run) and prints a percentage-delta table grouped by benchmark name, so a
+// CI job can post something like "Tova is 12% slower on prime_sieve, 3%
+// faster on result_option" against every PR.
+//
+// Usage:
+//
+//	benchcmp baseline.jsonl candidate.jsonl
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+type result struct {
+	Name   string  `json:"name"`
+	Mean   float64 `json:"mean_ms"`
+	Median float64 `json:"median_ms"`
+}
+
+func loadResults(path string) (map[string]result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	results := make(map[string]result)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var r result
+		if err := json.Unmarshal(line, &r); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		results[r.Name] = r
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// percentDelta is how much slower (positive) or faster (negative)
+// candidate is than baseline, as a percentage of baseline.
+func percentDelta(baseline, candidate float64) float64 {
+	if baseline == 0 {
+		return 0
+	}
+	return (candidate - baseline) / baseline * 100
+}
+
+func run(baselinePath, candidatePath string) error {
+	baseline, err := loadResults(baselinePath)
+	if err != nil {
+		return err
+	}
+	candidate, err := loadResults(candidatePath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%-30s %12s %12s %10s\n", "benchmark", "baseline_ms", "candidate_ms", "delta")
+	for name, b := range baseline {
+		c, ok := candidate[name]
+		if !ok {
+			fmt.Printf("%-30s %12.3f %12s %10s\n", name, b.Mean, "-", "missing")
+			continue
+		}
+		delta := percentDelta(b.Mean, c.Mean)
+		direction := "faster"
+		if delta > 0 {
+			direction = "slower"
+		}
+		fmt.Printf("%-30s %12.3f %12.3f %+9.1f%% (%s)\n", name, b.Mean, c.Mean, delta, direction)
+	}
+	for name := range candidate {
+		if _, ok := baseline[name]; !ok {
+			fmt.Printf("%-30s %12s %12.3f %10s\n", name, "-", candidate[name].Mean, "new")
+		}
+	}
+	return nil
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: benchcmp <baseline.jsonl> <candidate.jsonl>")
+		os.Exit(2)
+	}
+	if err := run(os.Args[1], os.Args[2]); err != nil {
+		fmt.Fprintln(os.Stderr, "benchcmp:", err)
+		os.Exit(1)
+	}
+}
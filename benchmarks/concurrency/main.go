@@ -0,0 +1,175 @@
+// Command concurrency is the Go baseline for the Tova concurrency suite. It
+// lives in its own module-rooted package (rather than
+// benchmarks/concurrent/bench_vs_go.go) so it can import benchmarks/driver:
+// the rest of benchmarks/concurrent is a directory of standalone,
+// single-file `go run`-able mains that can't share an import graph with
+// each other, since Go requires one package per directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sync"
+
+	"github.com/tova-lang/tova-lang/benchmarks/driver"
+)
+
+func fib(n int64) int64 {
+	var prev, curr int64 = 0, 1
+	for i := int64(0); i < n; i++ {
+		prev, curr = curr, prev+curr
+	}
+	return prev
+}
+
+func spawnOverhead() {
+	const n = 100_000
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(2)
+		go func() { wg.Done() }()
+		go func() { wg.Done() }()
+	}
+	wg.Wait()
+}
+
+func channelThroughput() int64 {
+	const n = 1_000_000
+	ch := make(chan int64, 1024)
+
+	var sum int64
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for val := range ch {
+			sum += val
+		}
+	}()
+
+	for i := int64(0); i < n; i++ {
+		ch <- i
+	}
+	close(ch)
+	wg.Wait()
+	return sum
+}
+
+func pingPong() int64 {
+	const n = 100_000
+	ping := make(chan int64)
+	pong := make(chan int64)
+
+	go func() {
+		for i := 0; i < n; i++ {
+			val := <-ping
+			pong <- val + 1
+		}
+	}()
+
+	var lastVal int64
+	for i := 0; i < n; i++ {
+		ping <- int64(i)
+		lastVal = <-pong
+	}
+	return lastVal
+}
+
+func fanOut() int64 {
+	const n = 100_000
+	const workers = 4
+	ch := make(chan int64, 256)
+
+	var sums [workers]int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for val := range ch {
+				sums[id] += val
+			}
+		}(w)
+	}
+
+	for i := int64(0); i < n; i++ {
+		ch <- i
+	}
+	close(ch)
+	wg.Wait()
+
+	var total int64
+	for _, s := range sums {
+		total += s
+	}
+	return total
+}
+
+// concurrentCompute runs fib(30) x reps on each of workers goroutines and
+// returns the combined checksum, the same workload benchmarks/concurrent
+// uses to compare sequential vs. concurrent scheduling overhead.
+func concurrentCompute() int64 {
+	const workers = 4
+	const fibN int64 = 30
+	const reps = 10_000
+
+	results := make([]int64, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			var sum int64
+			for r := 0; r < reps; r++ {
+				sum += fib(fibN)
+			}
+			results[idx] = sum
+		}(i)
+	}
+	wg.Wait()
+
+	var total int64
+	for _, r := range results {
+		total += r
+	}
+	return total
+}
+
+func main() {
+	opts := driver.Flags()
+	flag.Parse()
+	opts.ResolveFormat()
+
+	driver.Run("concurrency.spawn_overhead", opts, func() {
+		spawnOverhead()
+	}).Emit(opts)
+
+	var sum int64
+	r := driver.Run("concurrency.channel_throughput", opts, func() {
+		sum = channelThroughput()
+	})
+	r.ResultChecksum = fmt.Sprintf("checksum=%d", sum)
+	r.Emit(opts)
+
+	var lastVal int64
+	r = driver.Run("concurrency.ping_pong", opts, func() {
+		lastVal = pingPong()
+	})
+	r.ResultChecksum = fmt.Sprintf("checksum=%d", lastVal)
+	r.Emit(opts)
+
+	var fanSum int64
+	r = driver.Run("concurrency.fan_out", opts, func() {
+		fanSum = fanOut()
+	})
+	r.ResultChecksum = fmt.Sprintf("checksum=%d", fanSum)
+	r.Emit(opts)
+
+	var computeSum int64
+	r = driver.Run("concurrency.compute", opts, func() {
+		computeSum = concurrentCompute()
+	})
+	r.Params = map[string]string{"workers": "4"}
+	r.ResultChecksum = fmt.Sprintf("checksum=%d", computeSum)
+	r.Emit(opts)
+}
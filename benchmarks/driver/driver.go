@@ -0,0 +1,397 @@
+// Package driver is a profiling-aware benchmark runner shared by the Go
+// benchmark mains under benchmarks/. It wraps a benchmark function with
+// repeated execution, percentile/stddev reporting, optional CPU/heap/trace
+// profile capture, a text or JSON Lines output format for cross-runtime
+// comparison, and a small log-structured store for historical results
+// flushed the way Pebble flushes a memtable to a sorted run on disk.
+package driver
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"runtime/pprof"
+	"runtime/trace"
+	"sort"
+	"time"
+)
+
+// Format selects how a Result is printed.
+type Format int
+
+const (
+	// FormatText prints the BENCHMARK:/key=value blocks the benchmarks in
+	// this tree already use.
+	FormatText Format = iota
+	// FormatJSON prints one JSON object per result (JSON Lines), for
+	// cross-runtime comparison tooling such as cmd/benchcmp.
+	FormatJSON
+)
+
+// Options control how a benchmark is executed. Flags registers them on the
+// default flag set so a `*_bench.go` main picks up the same CLI surface by
+// calling driver.Flags() before flag.Parse().
+type Options struct {
+	CPUProfile string
+	MemProfile string
+	Trace      string
+	Count      int
+	Format     Format
+
+	Warmup    int
+	MinTime   time.Duration
+	GCOff     bool
+	PinCPU    bool
+	GCBetween bool
+}
+
+// Flags registers driver's flags on the default flag set. Call flag.Parse()
+// after Flags but before using the returned Options; ResolveFormat then
+// picks up whatever --format resolved to.
+func Flags() *Options {
+	opts := &Options{}
+	flag.StringVar(&opts.CPUProfile, "cpuprofile", "", "write a CPU profile to this file")
+	flag.StringVar(&opts.MemProfile, "memprofile", "", "write a heap profile to this file")
+	flag.StringVar(&opts.Trace, "trace", "", "write an execution trace to this file")
+	flag.IntVar(&opts.Count, "count", 5, "number of repetitions to run")
+	flag.String("format", "", `output format: "text" (default) or "json"`)
+	flag.IntVar(&opts.Warmup, "warmup", 0, "iterations to run and discard before measuring")
+	flag.DurationVar(&opts.MinTime, "min-time", 0, "grow iteration count until the measured run takes at least this long (like testing.B.N)")
+	flag.BoolVar(&opts.GCOff, "gc-off", false, "disable the GC (debug.SetGCPercent(-1)) around measurement")
+	flag.BoolVar(&opts.PinCPU, "pin-cpu", false, "lock the measuring goroutine to its OS thread and set GOMAXPROCS(1)")
+	flag.BoolVar(&opts.GCBetween, "gc-between", false, "force a GC between iterations, for steady-heap measurement of allocation-heavy benchmarks")
+	return opts
+}
+
+// ResolveFormat sets o.Format from --format (if passed) or BENCH_FORMAT
+// (checked as a fallback), defaulting to FormatText. Call it after
+// flag.Parse(), since flag values aren't populated before that.
+func (o *Options) ResolveFormat() {
+	value := os.Getenv("BENCH_FORMAT")
+	if f := flag.Lookup("format"); f != nil && f.Value.String() != "" {
+		value = f.Value.String()
+	}
+	switch value {
+	case "json", "jsonl":
+		o.Format = FormatJSON
+	default:
+		o.Format = FormatText
+	}
+}
+
+// Result is one benchmark's repeated-execution summary. Percentiles are
+// reported alongside min/mean so a handful of slow outliers — common in the
+// concurrency benchmarks' spawn overhead — don't get smoothed away by "best
+// time" or a plain average.
+type Result struct {
+	Suite          string            `json:"suite"`
+	Name           string            `json:"name"`
+	Runtime        string            `json:"runtime"` // "go" or "tova"
+	Params         map[string]string `json:"params,omitempty"`
+	ResultChecksum string            `json:"result_checksum,omitempty"`
+	Iters          int               `json:"iterations"`
+	TimesMs        []float64         `json:"time_ms"`
+	Min            float64           `json:"min_ms"`
+	Median         float64           `json:"median_ms"`
+	P95            float64           `json:"p95_ms"`
+	P99            float64           `json:"p99_ms"`
+	Max            float64           `json:"max_ms"`
+	Mean           float64           `json:"mean_ms"`
+	Stddev         float64           `json:"stddev_ms"`
+	AllocsPerOp    float64           `json:"allocs_per_op"`
+	BytesPerOp     float64           `json:"bytes_per_op"`
+	GCsPerOp       float64           `json:"gcs_per_op"`
+}
+
+// Run executes fn opts.Count times (at least once), capturing CPU/heap/trace
+// profiles around the whole run when configured, and returns timing stats
+// plus allocs/op, bytes/op, and GCs/op sampled from runtime.MemStats —
+// mirroring what testing.BenchmarkResult.MemString() reports for Go's own
+// benchmarks. opts.Warmup, opts.MinTime, opts.GCOff, opts.PinCPU, and
+// opts.GCBetween trade this determinism away further for stability, at the
+// cost of not reflecting a "cold", default-GOMAXPROCS run anymore.
+func Run(name string, opts *Options, fn func()) Result {
+	if opts.PinCPU {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		prevProcs := runtime.GOMAXPROCS(1)
+		defer runtime.GOMAXPROCS(prevProcs)
+	}
+	if opts.GCOff {
+		prevPercent := debug.SetGCPercent(-1)
+		defer debug.SetGCPercent(prevPercent)
+	}
+
+	stopProfiles := startProfiles(opts)
+	defer stopProfiles()
+
+	for i := 0; i < opts.Warmup; i++ {
+		fn()
+	}
+
+	count := opts.Count
+	if count < 1 {
+		count = 1
+	}
+	if opts.MinTime > 0 {
+		count = autoCount(fn, opts.MinTime)
+	}
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	times := make([]float64, 0, count)
+	for i := 0; i < count; i++ {
+		if opts.GCBetween && i > 0 {
+			runtime.GC()
+		}
+		start := time.Now()
+		fn()
+		times = append(times, time.Since(start).Seconds()*1000)
+	}
+
+	runtime.ReadMemStats(&after)
+
+	r := summarize(name, times)
+	r.Runtime = "go"
+	r.AllocsPerOp = float64(after.Mallocs-before.Mallocs) / float64(count)
+	r.BytesPerOp = float64(after.TotalAlloc-before.TotalAlloc) / float64(count)
+	r.GCsPerOp = float64(after.NumGC-before.NumGC) / float64(count)
+	return r
+}
+
+// autoCount doubles its iteration count, timing fn in a tight loop, until a
+// run takes at least minTime — the same growth testing.B uses to settle on
+// b.N before a "real" benchmark run.
+func autoCount(fn func(), minTime time.Duration) int {
+	n := 1
+	for {
+		start := time.Now()
+		for i := 0; i < n; i++ {
+			fn()
+		}
+		if time.Since(start) >= minTime {
+			return n
+		}
+		n *= 2
+	}
+}
+
+func startProfiles(opts *Options) func() {
+	var closers []func()
+
+	if opts.CPUProfile != "" {
+		if f, err := os.Create(opts.CPUProfile); err == nil {
+			pprof.StartCPUProfile(f)
+			closers = append(closers, func() {
+				pprof.StopCPUProfile()
+				f.Close()
+			})
+		}
+	}
+	if opts.Trace != "" {
+		if f, err := os.Create(opts.Trace); err == nil {
+			trace.Start(f)
+			closers = append(closers, func() {
+				trace.Stop()
+				f.Close()
+			})
+		}
+	}
+	if opts.MemProfile != "" {
+		path := opts.MemProfile
+		closers = append(closers, func() {
+			f, err := os.Create(path)
+			if err != nil {
+				return
+			}
+			defer f.Close()
+			pprof.WriteHeapProfile(f)
+		})
+	}
+
+	return func() {
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i]()
+		}
+	}
+}
+
+func summarize(name string, times []float64) Result {
+	sorted := append([]float64(nil), times...)
+	sort.Float64s(sorted)
+
+	mean, variance := welford(times)
+
+	return Result{
+		Name:    name,
+		Iters:   len(sorted),
+		TimesMs: times,
+		Min:     sorted[0],
+		Median:  percentile(sorted, 50),
+		P95:     percentile(sorted, 95),
+		P99:     percentile(sorted, 99),
+		Max:     sorted[len(sorted)-1],
+		Mean:    mean,
+		Stddev:  math.Sqrt(variance),
+	}
+}
+
+// welford computes the mean and population variance of times in one pass,
+// using Welford's online algorithm so a second pass over the samples isn't
+// needed just to compute stddev.
+func welford(times []float64) (mean, variance float64) {
+	var m2 float64
+	for i, t := range times {
+		n := float64(i + 1)
+		delta := t - mean
+		mean += delta / n
+		m2 += delta * (t - mean)
+	}
+	if len(times) > 0 {
+		variance = m2 / float64(len(times))
+	}
+	return mean, variance
+}
+
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// Print writes the result in the plain-text format the rest of
+// benchmarks/ already uses: a BENCHMARK: header followed by key=value
+// lines.
+func (r Result) Print() {
+	fmt.Printf("BENCHMARK: %s\n", r.Name)
+	if len(r.Params) > 0 {
+		keys := make([]string, 0, len(r.Params))
+		for k := range r.Params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Printf("%s=%s\n", k, r.Params[k])
+		}
+	}
+	fmt.Printf("iterations=%d\n", r.Iters)
+	fmt.Printf("best=%.6fms\n", r.Min)
+	fmt.Printf("median=%.6fms\n", r.Median)
+	fmt.Printf("avg=%.6fms\n", r.Mean)
+	fmt.Printf("stddev=%.6fms\n", r.Stddev)
+	fmt.Printf("allocs/op=%.2f, B/op=%.2f, gc/op=%.4f\n", r.AllocsPerOp, r.BytesPerOp, r.GCsPerOp)
+	if r.ResultChecksum != "" {
+		fmt.Println(r.ResultChecksum)
+	}
+}
+
+// Line formats r as a single stable key=value line a comparison tool can
+// parse without needing to track multi-line BENCHMARK: blocks.
+func (r Result) Line() string {
+	return fmt.Sprintf("bench=%s min=%.6f p50=%.6f p95=%.6f p99=%.6f max=%.6f mean=%.6f stddev=%.6f allocs_op=%.2f bytes_op=%.2f iters=%d",
+		r.Name, r.Min, r.Median, r.P95, r.P99, r.Max, r.Mean, r.Stddev, r.AllocsPerOp, r.BytesPerOp, r.Iters)
+}
+
+// PrintLine prints r.Line() followed by a newline.
+func (r Result) PrintLine() {
+	fmt.Println(r.Line())
+}
+
+// JSONLine marshals r as a single line of JSON, suitable for a JSONL file
+// that cmd/benchcmp (or any other cross-runtime comparison tool) can ingest.
+func (r Result) JSONLine() (string, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Emit prints r in whichever format opts.Format resolved to.
+func (r Result) Emit(opts *Options) {
+	if opts != nil && opts.Format == FormatJSON {
+		if line, err := r.JSONLine(); err == nil {
+			fmt.Println(line)
+			return
+		}
+	}
+	r.Print()
+}
+
+// --- Result storage ---------------------------------------------------
+//
+// ResultStore buffers incoming Results in memory (its "memtable") and
+// flushes them to an immutable, name-sorted JSONL segment file once the
+// buffer crosses a threshold — the same memtable -> sorted-run shape
+// Pebble uses for its LSM tree, scaled down to a few hundred results.
+
+const defaultFlushThreshold = 64
+
+type ResultStore struct {
+	dir       string
+	memtable  []Result
+	threshold int
+	segment   int
+}
+
+func NewResultStore(dir string) (*ResultStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &ResultStore{dir: dir, threshold: defaultFlushThreshold}, nil
+}
+
+// Put buffers r and flushes the memtable to a new segment once it crosses
+// the flush threshold.
+func (s *ResultStore) Put(r Result) error {
+	s.memtable = append(s.memtable, r)
+	if len(s.memtable) >= s.threshold {
+		return s.flush()
+	}
+	return nil
+}
+
+func (s *ResultStore) flush() error {
+	if len(s.memtable) == 0 {
+		return nil
+	}
+	sort.Slice(s.memtable, func(i, j int) bool { return s.memtable[i].Name < s.memtable[j].Name })
+
+	path := filepath.Join(s.dir, fmt.Sprintf("%06d.jsonl", s.segment))
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	for _, r := range s.memtable {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+
+	s.segment++
+	s.memtable = s.memtable[:0]
+	return nil
+}
+
+// Close flushes any buffered results regardless of threshold.
+func (s *ResultStore) Close() error {
+	return s.flush()
+}
@@ -0,0 +1,38 @@
+// Command fibonacci_recursive is the Go baseline for
+// tova_fibonacci_recursive.tova. It lives in its own module-rooted package
+// (rather than benchmarks/go/01_fibonacci_recursive.go) so it can import
+// benchmarks/driver: the rest of benchmarks/go is a directory of
+// standalone, single-file `go run`-able mains that can't share an import
+// graph with each other, since Go requires one package per directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/tova-lang/tova-lang/benchmarks/driver"
+)
+
+func fib(n int) int {
+	if n <= 1 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+func main() {
+	opts := driver.Flags()
+	flag.Parse()
+	opts.ResolveFormat()
+
+	const n = 35
+	fib(20) // warm up the call stack / branch predictor before measuring
+
+	var result int
+	r := driver.Run("fibonacci_recursive", opts, func() {
+		result = fib(n)
+	})
+	r.Params = map[string]string{"n": fmt.Sprintf("%d", n)}
+	r.ResultChecksum = fmt.Sprintf("result=%d", result)
+	r.Emit(opts)
+}
@@ -0,0 +1,168 @@
+// Go baseline for fuzzy.tova. Implements an fzf-style forward fuzzy match:
+// greedily match pattern runes against a haystack case-insensitively, then
+// run a second pass over the matched positions to compute a bonus score
+// from character-class transitions (the same boundary scoring fzf uses to
+// prefer word-start and camelCase matches over matches buried mid-word).
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// charClass is the character category used for boundary scoring.
+type charClass int
+
+const (
+	charNonWord charClass = iota
+	charLower
+	charUpper
+	charNumber
+)
+
+func classify(r rune) charClass {
+	switch {
+	case unicode.IsLower(r):
+		return charLower
+	case unicode.IsUpper(r):
+		return charUpper
+	case unicode.IsDigit(r):
+		return charNumber
+	default:
+		return charNonWord
+	}
+}
+
+// Result is the span a match covers in the haystack plus its bonus score.
+type Result struct {
+	Start int
+	End   int
+	Bonus int
+}
+
+// fuzzyMatch finds the first position where every rune of pattern occurs,
+// in order, within haystack (case-insensitive), then scores the match by
+// walking the matched positions and rewarding boundary transitions: +2
+// when a match lands right after a non-word rune (a word start), +1 for a
+// lower->upper camelCase boundary, and +1 for runs of consecutive matches.
+func fuzzyMatch(pattern, haystack []rune) (Result, bool) {
+	if len(pattern) == 0 {
+		return Result{}, false
+	}
+
+	positions := make([]int, 0, len(pattern))
+	pi := 0
+	for hi, hr := range haystack {
+		if pi == len(pattern) {
+			break
+		}
+		if unicode.ToLower(hr) == unicode.ToLower(pattern[pi]) {
+			positions = append(positions, hi)
+			pi++
+		}
+	}
+	if pi != len(pattern) {
+		return Result{}, false
+	}
+
+	bonus := 0
+	for i, pos := range positions {
+		class := classify(haystack[pos])
+		if pos == 0 {
+			bonus += 2
+		} else {
+			prevClass := classify(haystack[pos-1])
+			if prevClass == charNonWord {
+				bonus += 2
+			} else if prevClass == charLower && class == charUpper {
+				bonus += 1
+			}
+		}
+		if i > 0 && pos == positions[i-1]+1 {
+			bonus += 1
+		}
+	}
+
+	return Result{Start: positions[0], End: positions[len(positions)-1] + 1, Bonus: bonus}, true
+}
+
+// pathSegments mirrors the kind of tokens that show up in real file paths
+// and identifiers — the realistic corpus fzf itself is usually run over.
+var pathSegments = []string{
+	"src", "internal", "pkg", "cmd", "benchmarks", "driver", "fuzzy_match",
+	"handler", "controller", "service", "repository", "model", "util",
+	"http", "json", "config", "test", "main", "server", "client", "auth",
+	"middleware", "router", "parser", "lexer", "compiler", "runtime",
+}
+
+var extensions = []string{".go", ".ts", ".py", ".rs", ".md", ".json", ".yaml"}
+
+func generateCorpus(n int, seed int64) []string {
+	rng := rand.New(rand.NewSource(seed))
+	corpus := make([]string, n)
+	for i := 0; i < n; i++ {
+		depth := 2 + rng.Intn(4)
+		parts := make([]string, depth)
+		for d := 0; d < depth; d++ {
+			parts[d] = pathSegments[rng.Intn(len(pathSegments))]
+		}
+		parts[depth-1] += extensions[rng.Intn(len(extensions))]
+		corpus[i] = strings.Join(parts, "/")
+	}
+	return corpus
+}
+
+var queryPatterns = []string{
+	"src", "hdlr", "ctrl", "svc", "json", "cfg", "test", "main",
+	"srv", "cli", "auth", "mw", "rtr", "psr", "lxr", "cmp", "rt",
+	"bnch", "drv", "fm",
+}
+
+func benchmarkFuzzyMatch(corpus []string, patterns []string, rounds int) {
+	corpusRunes := make([][]rune, len(corpus))
+	for i, s := range corpus {
+		corpusRunes[i] = []rune(s)
+	}
+	patternRunes := make([][]rune, len(patterns))
+	for i, p := range patterns {
+		patternRunes[i] = []rune(p)
+	}
+
+	runtime.GC()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	start := time.Now()
+	matches := 0
+	bonusSum := 0
+	for r := 0; r < rounds; r++ {
+		for _, p := range patternRunes {
+			for _, h := range corpusRunes {
+				if res, ok := fuzzyMatch(p, h); ok {
+					matches++
+					bonusSum += res.Bonus
+				}
+			}
+		}
+	}
+	elapsed := time.Since(start).Seconds() * 1000
+	runtime.ReadMemStats(&after)
+
+	ops := rounds * len(patternRunes) * len(corpusRunes)
+	fmt.Printf("  fuzzy match (%d strings x %d patterns x %d rounds): %.6fms, matches=%d, bonus_sum=%d, allocs/op=%.4f, B/op=%.4f\n",
+		len(corpusRunes), len(patternRunes), rounds, elapsed, matches, bonusSum,
+		float64(after.Mallocs-before.Mallocs)/float64(ops),
+		float64(after.TotalAlloc-before.TotalAlloc)/float64(ops))
+}
+
+func main() {
+	fmt.Println("BENCHMARK: fuzzy_match")
+
+	corpus := generateCorpus(50000, 42)
+	benchmarkFuzzyMatch(corpus, queryPatterns, 1)
+	benchmarkFuzzyMatch(corpus, queryPatterns, 3)
+}
@@ -0,0 +1,77 @@
+package main
+
+// Go baseline for tova_binary_trees.tova — part of the Computer Language
+// Benchmarks Game shootout. Stresses allocator/GC churn via recursive
+// construction and full traversal of short-lived binary trees.
+
+import (
+	"fmt"
+	"time"
+)
+
+type treeNode struct {
+	left, right *treeNode
+}
+
+func buildTree(depth int) *treeNode {
+	if depth == 0 {
+		return &treeNode{}
+	}
+	return &treeNode{left: buildTree(depth - 1), right: buildTree(depth - 1)}
+}
+
+func itemCheck(n *treeNode) int {
+	if n.left == nil {
+		return 1
+	}
+	return 1 + itemCheck(n.left) + itemCheck(n.right)
+}
+
+func binaryTrees(maxDepth int) (int, int) {
+	stretchDepth := maxDepth + 1
+	stretchTree := buildTree(stretchDepth)
+	stretchCheck := itemCheck(stretchTree)
+
+	longLivedTree := buildTree(maxDepth)
+
+	checksTotal := 0
+	for depth := 4; depth <= maxDepth; depth += 2 {
+		iterations := 1 << uint(maxDepth-depth+4)
+		for i := 0; i < iterations; i++ {
+			checksTotal += itemCheck(buildTree(depth))
+		}
+	}
+
+	return stretchCheck + itemCheck(longLivedTree), checksTotal
+}
+
+func main() {
+	maxDepth := 18
+	iterations := 3
+
+	times := make([]float64, 0, iterations)
+	var stretchAndLongLived, checksTotal int
+
+	for iter := 0; iter < iterations; iter++ {
+		start := time.Now()
+		stretchAndLongLived, checksTotal = binaryTrees(maxDepth)
+		elapsed := time.Since(start).Seconds() * 1000
+		times = append(times, elapsed)
+	}
+
+	best := times[0]
+	sum := 0.0
+	for _, t := range times {
+		if t < best {
+			best = t
+		}
+		sum += t
+	}
+	avg := sum / float64(len(times))
+
+	fmt.Println("BENCHMARK: binary_trees")
+	fmt.Printf("max_depth=%d, iterations=%d\n", maxDepth, iterations)
+	fmt.Printf("stretch_and_long_lived=%d, checks_total=%d\n", stretchAndLongLived, checksTotal)
+	fmt.Printf("best=%.6fms\n", best)
+	fmt.Printf("avg=%.6fms\n", avg)
+}
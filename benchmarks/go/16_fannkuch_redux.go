@@ -0,0 +1,101 @@
+package main
+
+// Go baseline for tova_fannkuch_redux.tova — part of the Computer Language
+// Benchmarks Game shootout. Enumerates permutations via Heap's algorithm and
+// counts flips, exercising array indexing and tight integer loops.
+
+import (
+	"fmt"
+	"time"
+)
+
+func fannkuchRedux(n int) (int, int) {
+	perm := make([]int, n)
+	perm1 := make([]int, n)
+	count := make([]int, n)
+	for i := 0; i < n; i++ {
+		perm1[i] = i
+	}
+
+	maxFlips := 0
+	checksum := 0
+	permCount := 0
+	r := n
+
+	for {
+		for ; r > 1; r-- {
+			count[r-1] = r
+		}
+		copy(perm, perm1)
+
+		// Flip the prefix up to the value at perm[0] until it settles at 0.
+		flips := 0
+		for perm[0] != 0 {
+			k := perm[0]
+			for lo, hi := 0, k; lo < hi; lo, hi = lo+1, hi-1 {
+				perm[lo], perm[hi] = perm[hi], perm[lo]
+			}
+			flips++
+		}
+
+		if flips > maxFlips {
+			maxFlips = flips
+		}
+		if permCount%2 == 0 {
+			checksum += flips
+		} else {
+			checksum -= flips
+		}
+
+		// Generate the next permutation by rotating the prefix of length
+		// r+1 left by one and cascading the count array.
+		for {
+			if r == n {
+				return checksum, maxFlips
+			}
+			first := perm1[0]
+			for j := 0; j < r; j++ {
+				perm1[j] = perm1[j+1]
+			}
+			perm1[r] = first
+
+			count[r]--
+			if count[r] > 0 {
+				break
+			}
+			r++
+		}
+		permCount++
+	}
+}
+
+func main() {
+	n := 10
+	iterations := 3
+
+	times := make([]float64, 0, iterations)
+	var checksum, maxFlips int
+
+	for iter := 0; iter < iterations; iter++ {
+		start := time.Now()
+		checksum, maxFlips = fannkuchRedux(n)
+		elapsed := time.Since(start).Seconds() * 1000
+		times = append(times, elapsed)
+	}
+
+	best := times[0]
+	sum := 0.0
+	for _, t := range times {
+		if t < best {
+			best = t
+		}
+		sum += t
+	}
+	avg := sum / float64(len(times))
+
+	fmt.Println("BENCHMARK: fannkuch_redux")
+	fmt.Printf("n=%d, iterations=%d\n", n, iterations)
+	fmt.Printf("checksum=%d, max_flips=%d\n", checksum, maxFlips)
+	fmt.Printf("best=%.6fms\n", best)
+	fmt.Printf("avg=%.6fms\n", avg)
+}
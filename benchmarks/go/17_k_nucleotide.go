@@ -0,0 +1,69 @@
+package main
+
+// Go baseline for tova_k_nucleotide.tova — part of the Computer Language
+// Benchmarks Game shootout. Builds k-mer frequency tables over a synthetic
+// DNA sequence, exercising map inserts/lookups with string keys.
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+func generateSequence(n int, seed int64) []byte {
+	bases := []byte{'A', 'C', 'G', 'T'}
+	rng := rand.New(rand.NewSource(seed))
+	seq := make([]byte, n)
+	for i := range seq {
+		seq[i] = bases[rng.Intn(len(bases))]
+	}
+	return seq
+}
+
+func countKmers(seq []byte, k int) map[string]int {
+	counts := make(map[string]int)
+	for i := 0; i+k <= len(seq); i++ {
+		counts[string(seq[i:i+k])]++
+	}
+	return counts
+}
+
+func kNucleotide(seq []byte) (int, int, int) {
+	one := countKmers(seq, 1)
+	two := countKmers(seq, 2)
+	specific := countKmers(seq, 18)["GGTATTTTAATTTATAGT"]
+	return len(one), len(two), specific
+}
+
+func main() {
+	n := 500000
+	iterations := 3
+
+	seq := generateSequence(n, 42)
+
+	times := make([]float64, 0, iterations)
+	var oneCount, twoCount, specificCount int
+
+	for iter := 0; iter < iterations; iter++ {
+		start := time.Now()
+		oneCount, twoCount, specificCount = kNucleotide(seq)
+		elapsed := time.Since(start).Seconds() * 1000
+		times = append(times, elapsed)
+	}
+
+	best := times[0]
+	sum := 0.0
+	for _, t := range times {
+		if t < best {
+			best = t
+		}
+		sum += t
+	}
+	avg := sum / float64(len(times))
+
+	fmt.Println("BENCHMARK: k_nucleotide")
+	fmt.Printf("sequence_len=%d, iterations=%d\n", n, iterations)
+	fmt.Printf("distinct_1mers=%d, distinct_2mers=%d, ggtattttaattttatagt=%d\n", oneCount, twoCount, specificCount)
+	fmt.Printf("best=%.6fms\n", best)
+	fmt.Printf("avg=%.6fms\n", avg)
+}
@@ -0,0 +1,79 @@
+package main
+
+// Go baseline for tova_mandelbrot.tova — part of the Computer Language
+// Benchmarks Game shootout. Renders a Mandelbrot set to a packed 1-bit
+// bitmap, exercising the complex-number inner loop and pixel output.
+
+import (
+	"fmt"
+	"time"
+)
+
+func mandelbrot(size int) []byte {
+	const limit = 2.0
+	const maxIter = 50
+
+	bytesPerRow := (size + 7) / 8
+	pixels := make([]byte, bytesPerRow*size)
+
+	for y := 0; y < size; y++ {
+		ci := (2.0*float64(y))/float64(size) - 1.0
+		for xByte := 0; xByte < bytesPerRow; xByte++ {
+			var byteVal byte
+			for bit := 0; bit < 8; bit++ {
+				x := xByte*8 + bit
+				if x >= size {
+					break
+				}
+				cr := (2.0*float64(x))/float64(size) - 1.5
+
+				var zr, zi float64
+				iter := 0
+				for zr*zr+zi*zi <= limit*limit && iter < maxIter {
+					zr, zi = zr*zr-zi*zi+cr, 2*zr*zi+ci
+					iter++
+				}
+				if iter == maxIter {
+					byteVal |= 1 << uint(7-bit)
+				}
+			}
+			pixels[y*bytesPerRow+xByte] = byteVal
+		}
+	}
+	return pixels
+}
+
+func main() {
+	size := 1600
+	iterations := 3
+
+	times := make([]float64, 0, iterations)
+	var checksum int
+
+	for iter := 0; iter < iterations; iter++ {
+		start := time.Now()
+		pixels := mandelbrot(size)
+		elapsed := time.Since(start).Seconds() * 1000
+		checksum = 0
+		for _, b := range pixels {
+			checksum += int(b)
+		}
+		times = append(times, elapsed)
+	}
+
+	best := times[0]
+	sum := 0.0
+	for _, t := range times {
+		if t < best {
+			best = t
+		}
+		sum += t
+	}
+	avg := sum / float64(len(times))
+
+	fmt.Println("BENCHMARK: mandelbrot")
+	fmt.Printf("size=%dx%d, iterations=%d\n", size, size, iterations)
+	fmt.Printf("checksum=%d\n", checksum)
+	fmt.Printf("best=%.6fms\n", best)
+	fmt.Printf("avg=%.6fms\n", avg)
+}
@@ -0,0 +1,207 @@
+package main
+
+// Go baseline for tova_meteor_contest.tova — part of the Computer Language
+// Benchmarks Game shootout. Backtracking search that tiles a 5x10 hexagonal
+// board with 10 pentomino-like pieces, exercising recursion and bitmask
+// pruning.
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	boardWidth  = 5
+	boardHeight = 10
+	boardCells  = boardWidth * boardHeight
+)
+
+// pieceMasks[piece][orientation] is a bitmask (bit i == board cell i) for
+// one placement of the piece anchored at its top-left cell.
+var pieceShapes = [10][][2]int{
+	{{0, 0}, {1, 0}, {0, 1}, {0, 2}, {1, 2}},
+	{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 3}},
+	{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}},
+	{{0, 0}, {0, 1}, {1, 1}, {2, 1}, {2, 0}},
+	{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {1, 1}},
+	{{0, 0}, {0, 1}, {1, 1}, {1, 2}, {2, 2}},
+	{{0, 0}, {1, 0}, {2, 0}, {0, 1}, {0, 2}},
+	{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {3, 1}},
+	{{0, 0}, {1, 0}, {1, 1}, {2, 1}, {3, 1}},
+	{{0, 0}, {1, 0}, {2, 0}, {1, 1}, {1, 2}},
+}
+
+func cellIndex(x, y int) (int, bool) {
+	if x < 0 || x >= boardWidth || y < 0 || y >= boardHeight {
+		return 0, false
+	}
+	return y*boardWidth + x, true
+}
+
+// normalize shifts a shape so its minimum x and y are both 0.
+func normalize(shape [][2]int) [][2]int {
+	minX, minY := shape[0][0], shape[0][1]
+	for _, c := range shape {
+		if c[0] < minX {
+			minX = c[0]
+		}
+		if c[1] < minY {
+			minY = c[1]
+		}
+	}
+	out := make([][2]int, len(shape))
+	for i, c := range shape {
+		out[i] = [2]int{c[0] - minX, c[1] - minY}
+	}
+	return out
+}
+
+func rotate90(shape [][2]int) [][2]int {
+	out := make([][2]int, len(shape))
+	for i, c := range shape {
+		out[i] = [2]int{-c[1], c[0]}
+	}
+	return normalize(out)
+}
+
+func reflect(shape [][2]int) [][2]int {
+	out := make([][2]int, len(shape))
+	for i, c := range shape {
+		out[i] = [2]int{-c[0], c[1]}
+	}
+	return normalize(out)
+}
+
+func shapeKey(shape [][2]int) [10]int {
+	var key [10]int
+	for i, c := range shape {
+		key[2*i] = c[0]
+		key[2*i+1] = c[1]
+	}
+	return key
+}
+
+// orientations returns every distinct rotation/reflection of shape.
+func orientations(shape [][2]int) [][][2]int {
+	seen := make(map[[10]int]bool)
+	var out [][][2]int
+	cur := normalize(shape)
+	for _, mirrored := range []bool{false, true} {
+		s := cur
+		if mirrored {
+			s = reflect(cur)
+		}
+		for i := 0; i < 4; i++ {
+			if key := shapeKey(s); !seen[key] {
+				seen[key] = true
+				out = append(out, s)
+			}
+			s = rotate90(s)
+		}
+	}
+	return out
+}
+
+// placements enumerates every (mask, pieceID) pair obtainable by rotating,
+// reflecting, and sliding each piece shape to every board position.
+func placements() [][2]int {
+	var result [][2]int
+	for id, shape := range pieceShapes {
+		for _, orientation := range orientations(shape) {
+			for oy := 0; oy < boardHeight; oy++ {
+				for ox := 0; ox < boardWidth; ox++ {
+					mask := 0
+					ok := true
+					for _, c := range orientation {
+						idx, inBounds := cellIndex(ox+c[0], oy+c[1])
+						if !inBounds {
+							ok = false
+							break
+						}
+						mask |= 1 << uint(idx)
+					}
+					if ok {
+						result = append(result, [2]int{mask, id})
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// solve performs a depth-first backtracking search and stops at the first
+// complete tiling, mirroring the "first solution" variant of the classic
+// meteor-contest benchmark — the full enumeration of all ~2000 solutions on
+// a 5x10 board is too slow to run as a steady-state micro-benchmark.
+func solve(all [][2]int, used int, placed int, occupied int, visited *int) bool {
+	*visited++
+	if placed == len(pieceShapes) {
+		return true
+	}
+	// Find the first empty cell to keep the search tree narrow.
+	firstEmpty := -1
+	for i := 0; i < boardCells; i++ {
+		if occupied&(1<<uint(i)) == 0 {
+			firstEmpty = i
+			break
+		}
+	}
+	if firstEmpty == -1 {
+		return false
+	}
+	for _, p := range all {
+		mask, id := p[0], p[1]
+		if used&(1<<uint(id)) != 0 {
+			continue
+		}
+		if mask&occupied != 0 {
+			continue
+		}
+		if mask&(1<<uint(firstEmpty)) == 0 {
+			continue
+		}
+		if solve(all, used|(1<<uint(id)), placed+1, occupied|mask, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+func meteorContest() (bool, int) {
+	all := placements()
+	visited := 0
+	found := solve(all, 0, 0, 0, &visited)
+	return found, visited
+}
+
+func main() {
+	iterations := 3
+
+	times := make([]float64, 0, iterations)
+	var found bool
+	var visited int
+
+	for iter := 0; iter < iterations; iter++ {
+		start := time.Now()
+		found, visited = meteorContest()
+		elapsed := time.Since(start).Seconds() * 1000
+		times = append(times, elapsed)
+	}
+
+	best := times[0]
+	sum := 0.0
+	for _, t := range times {
+		if t < best {
+			best = t
+		}
+		sum += t
+	}
+	avg := sum / float64(len(times))
+
+	fmt.Println("BENCHMARK: meteor_contest")
+	fmt.Printf("board=%dx%d, iterations=%d\n", boardWidth, boardHeight, iterations)
+	fmt.Printf("found_solution=%v, nodes_visited=%d\n", found, visited)
+	fmt.Printf("best=%.6fms\n", best)
+	fmt.Printf("avg=%.6fms\n", avg)
+}
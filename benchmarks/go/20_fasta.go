@@ -0,0 +1,145 @@
+package main
+
+// Go baseline for tova_fasta.tova — part of the Computer Language Benchmarks
+// Game shootout. Generates FASTA-formatted DNA sequences from a weighted
+// random model, exercising a stateful PRNG and buffered byte I/O.
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"time"
+)
+
+const lineWidth = 60
+
+type aminoAcid struct {
+	symbol byte
+	prob   float64
+}
+
+// baseFrequencies is the Homo sapiens base-frequency table; the real
+// benchmarks-game task also has a wider IUB ambiguity-code table for the
+// "TWO" sequence, which we elide here to keep this a pure PRNG/IO exercise.
+var baseFrequencies = []aminoAcid{
+	{'a', 0.3029549426680},
+	{'c', 0.1979883004921},
+	{'g', 0.1975473066391},
+	{'t', 0.3015094502008},
+}
+
+// pseudoRandom is the linear-congruential generator specified by the
+// benchmarks-game fasta task so output is reproducible across runtimes.
+type pseudoRandom struct {
+	seed uint32
+}
+
+const (
+	randIM = 139968
+	randIA = 3877
+	randIC = 29573
+)
+
+func (r *pseudoRandom) next() float64 {
+	r.seed = (r.seed*randIA + randIC) % randIM
+	return float64(r.seed) / float64(randIM)
+}
+
+func writeRepeating(w io.Writer, header string, alu string, n int) {
+	fmt.Fprintln(w, header)
+	buf := make([]byte, 0, len(alu))
+	pos := 0
+	for n > 0 {
+		lineLen := lineWidth
+		if n < lineLen {
+			lineLen = n
+		}
+		buf = buf[:0]
+		for i := 0; i < lineLen; i++ {
+			buf = append(buf, alu[pos])
+			pos = (pos + 1) % len(alu)
+		}
+		buf = append(buf, '\n')
+		w.Write(buf)
+		n -= lineLen
+	}
+}
+
+func writeRandom(w io.Writer, rng *pseudoRandom, header string, acids []aminoAcid, n int) {
+	fmt.Fprintln(w, header)
+
+	cumulative := make([]float64, len(acids))
+	sum := 0.0
+	for i, a := range acids {
+		sum += a.prob
+		cumulative[i] = sum
+	}
+
+	line := make([]byte, lineWidth+1)
+	for n > 0 {
+		lineLen := lineWidth
+		if n < lineLen {
+			lineLen = n
+		}
+		for i := 0; i < lineLen; i++ {
+			r := rng.next()
+			idx := 0
+			for r > cumulative[idx] {
+				idx++
+			}
+			line[i] = acids[idx].symbol
+		}
+		line[lineLen] = '\n'
+		w.Write(line[:lineLen+1])
+		n -= lineLen
+	}
+}
+
+const aluSeq = "GGCCGGGCGCGGTGGCTCACGCCTGTAATCCCAGCACTTTGGGAGGCCGAGGCGGGCGGA" +
+	"TCACCTGAGGTCAGGAGTTCGAGACCAGCCTGGCCAACATGGTGAAACCCCGTCTCTACT" +
+	"AAAAATACAAAAATTAGCCGGGCGTGGTGGCGCGCGCCTGTAATCCCAGCTACTCGGGAG" +
+	"GCTGAGGCAGGAGAATCGCTTGAACCCGGGAGGCGGAGGTTGCAGTGAGCCGAGATCGCG" +
+	"CCACTGCACTCCAGCCTGGGCGACAGAGCGAGACTCCGTCTCAAAAA"
+
+func fasta(n int) int {
+	buf := bufio.NewWriterSize(io.Discard, 64*1024)
+	rng := &pseudoRandom{seed: 42}
+
+	writeRepeating(buf, ">ONE Homo sapiens alu", aluSeq, n*2)
+	writeRandom(buf, rng, ">TWO IUB ambiguity codes", baseFrequencies, n*3)
+	writeRandom(buf, rng, ">THREE Homo sapiens frequency", baseFrequencies, n*5)
+
+	buf.Flush()
+	return int(rng.seed)
+}
+
+func main() {
+	n := 1000000
+	iterations := 3
+
+	times := make([]float64, 0, iterations)
+	var finalSeed int
+
+	for iter := 0; iter < iterations; iter++ {
+		start := time.Now()
+		finalSeed = fasta(n)
+		elapsed := time.Since(start).Seconds() * 1000
+		times = append(times, elapsed)
+	}
+
+	best := times[0]
+	sum := 0.0
+	for _, t := range times {
+		if t < best {
+			best = t
+		}
+		sum += t
+	}
+	avg := sum / float64(len(times))
+
+	fmt.Println("BENCHMARK: fasta")
+	fmt.Printf("n=%d, iterations=%d\n", n, iterations)
+	fmt.Printf("final_seed=%d\n", finalSeed)
+	fmt.Printf("best=%.6fms\n", best)
+	fmt.Printf("avg=%.6fms\n", avg)
+}
@@ -0,0 +1,246 @@
+package main
+
+// Go baseline for tova_nn_inference.tova. Runs a small but realistic CNN
+// forward pass — Conv3x3 stride 1 (valid) on a 224x224x3 input to 32
+// channels, ReLU, MaxPool 2x2, Conv3x3 to 64 channels, ReLU, global-average
+// pool, fully-connected to 1000 logits — in NHWC layout, float32, with both
+// convolutions implemented as im2col + GEMM so they reuse the same matmul
+// kernel shape as matrix_multiply.go. Reports per-stage ms, total ms, and
+// GFLOP/s, since the point of this benchmark is how close each runtime
+// gets to the hardware ceiling on the inner GEMM — a far more compute-bound
+// workload than the integer matrix_multiply bench.
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// im2col unrolls every valid kh x kw x inC patch of an NHWC input into a
+// row of the output matrix, so a convolution becomes a single GEMM: the
+// returned matrix has outH*outW rows and kh*kw*inC columns.
+func im2col(input []float32, h, w, inC, kh, kw int) (col []float32, outH, outW int) {
+	outH = h - kh + 1
+	outW = w - kw + 1
+	rowLen := kh * kw * inC
+	col = make([]float32, outH*outW*rowLen)
+
+	for oy := 0; oy < outH; oy++ {
+		for ox := 0; ox < outW; ox++ {
+			row := col[(oy*outW+ox)*rowLen : (oy*outW+ox)*rowLen+rowLen]
+			i := 0
+			for ky := 0; ky < kh; ky++ {
+				srcStart := ((oy+ky)*w + ox) * inC
+				copy(row[i:i+kw*inC], input[srcStart:srcStart+kw*inC])
+				i += kw * inC
+			}
+		}
+	}
+	return col, outH, outW
+}
+
+// gemmF32 computes a (m x k) * b (k x n), both row-major, returning a flat
+// m x n row-major result.
+func gemmF32(a []float32, m, k int, b []float32, n int) []float32 {
+	out := make([]float32, m*n)
+	for i := 0; i < m; i++ {
+		aRow := a[i*k : i*k+k]
+		outRow := out[i*n : i*n+n]
+		for p := 0; p < k; p++ {
+			av := aRow[p]
+			if av == 0 {
+				continue
+			}
+			bRow := b[p*n : p*n+n]
+			for j := 0; j < n; j++ {
+				outRow[j] += av * bRow[j]
+			}
+		}
+	}
+	return out
+}
+
+// conv2DIm2col runs a valid, stride-1 convolution via im2col + GEMM and
+// returns the NHWC output plus the GEMM's FLOP count (2*M*K*N multiply-add
+// pairs).
+func conv2DIm2col(input []float32, h, w, inC int, weights []float32, kh, kw, outC int) (output []float32, outH, outW int, flops int64) {
+	col, outH, outW := im2col(input, h, w, inC, kh, kw)
+	m := outH * outW
+	k := kh * kw * inC
+	output = gemmF32(col, m, k, weights, outC)
+	flops = 2 * int64(m) * int64(k) * int64(outC)
+	return output, outH, outW, flops
+}
+
+func reluF32(x []float32) {
+	for i, v := range x {
+		if v < 0 {
+			x[i] = 0
+		}
+	}
+}
+
+// maxPool2x2 halves height and width (truncating odd dimensions) by taking
+// the max over each non-overlapping 2x2 window, per channel, in NHWC.
+func maxPool2x2(input []float32, h, w, c int) (output []float32, outH, outW int) {
+	outH, outW = h/2, w/2
+	output = make([]float32, outH*outW*c)
+	for oy := 0; oy < outH; oy++ {
+		for ox := 0; ox < outW; ox++ {
+			for ch := 0; ch < c; ch++ {
+				a := input[((oy*2)*w+ox*2)*c+ch]
+				b := input[((oy*2)*w+ox*2+1)*c+ch]
+				cc := input[((oy*2+1)*w+ox*2)*c+ch]
+				d := input[((oy*2+1)*w+ox*2+1)*c+ch]
+				m := a
+				if b > m {
+					m = b
+				}
+				if cc > m {
+					m = cc
+				}
+				if d > m {
+					m = d
+				}
+				output[(oy*outW+ox)*c+ch] = m
+			}
+		}
+	}
+	return output, outH, outW
+}
+
+// globalAvgPool reduces an h x w x c NHWC tensor to a c-length vector by
+// averaging over the spatial dimensions.
+func globalAvgPool(input []float32, h, w, c int) []float32 {
+	out := make([]float32, c)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			base := (y*w + x) * c
+			for ch := 0; ch < c; ch++ {
+				out[ch] += input[base+ch]
+			}
+		}
+	}
+	n := float32(h * w)
+	for ch := range out {
+		out[ch] /= n
+	}
+	return out
+}
+
+// fcF32 computes a dense layer (inDim -> outDim) and its FLOP count.
+func fcF32(input []float32, weights []float32, bias []float32, inDim, outDim int) (output []float32, flops int64) {
+	out := gemmF32(input, 1, inDim, weights, outDim)
+	for i := range out {
+		out[i] += bias[i]
+	}
+	return out, 2 * int64(inDim) * int64(outDim)
+}
+
+func makeTensorF32(n int, seed float32) []float32 {
+	out := make([]float32, n)
+	for i := range out {
+		out[i] = float32(math.Sin(float64(seed) + float64(i)*0.01))
+	}
+	return out
+}
+
+type stageTiming struct {
+	name string
+	ms   float64
+}
+
+func inferenceOnce(image []float32, h, w, inC int, conv1W []float32, outC1 int, conv2W []float32, outC2 int, fcW, fcBias []float32, numClasses int) (logits []float32, timings []stageTiming, totalFlops int64) {
+	start := time.Now()
+	conv1Out, h1, w1, flops1 := conv2DIm2col(image, h, w, inC, conv1W, 3, 3, outC1)
+	reluF32(conv1Out)
+	timings = append(timings, stageTiming{"conv1+relu", time.Since(start).Seconds() * 1000})
+	totalFlops += flops1
+
+	start = time.Now()
+	pooled, hp, wp := maxPool2x2(conv1Out, h1, w1, outC1)
+	timings = append(timings, stageTiming{"maxpool", time.Since(start).Seconds() * 1000})
+
+	start = time.Now()
+	conv2Out, h2, w2, flops2 := conv2DIm2col(pooled, hp, wp, outC1, conv2W, 3, 3, outC2)
+	reluF32(conv2Out)
+	timings = append(timings, stageTiming{"conv2+relu", time.Since(start).Seconds() * 1000})
+	totalFlops += flops2
+
+	start = time.Now()
+	pooledFlat := globalAvgPool(conv2Out, h2, w2, outC2)
+	timings = append(timings, stageTiming{"global_avg_pool", time.Since(start).Seconds() * 1000})
+
+	start = time.Now()
+	logits, flops3 := fcF32(pooledFlat, fcW, fcBias, outC2, numClasses)
+	timings = append(timings, stageTiming{"fc", time.Since(start).Seconds() * 1000})
+	totalFlops += flops3
+
+	return logits, timings, totalFlops
+}
+
+func main() {
+	const (
+		imageSize  = 224
+		inChannels = 3
+		outC1      = 32
+		outC2      = 64
+		numClasses = 1000
+		iterations = 3
+	)
+
+	image := makeTensorF32(imageSize*imageSize*inChannels, 0.0)
+	conv1W := makeTensorF32(3*3*inChannels*outC1, 1.0)
+	conv2W := makeTensorF32(3*3*outC1*outC2, 2.0)
+	fcW := makeTensorF32(outC2*numClasses, 3.0)
+	fcBias := make([]float32, numClasses)
+
+	// Warmup
+	_, _, _ = inferenceOnce(image, imageSize, imageSize, inChannels, conv1W, outC1, conv2W, outC2, fcW, fcBias, numClasses)
+
+	totalTimes := make([]float64, 0, iterations)
+	stageTotals := map[string]float64{}
+	stageOrder := []string{"conv1+relu", "maxpool", "conv2+relu", "global_avg_pool", "fc"}
+	var logits []float32
+	var flops int64
+
+	for iter := 0; iter < iterations; iter++ {
+		start := time.Now()
+		var timings []stageTiming
+		logits, timings, flops = inferenceOnce(image, imageSize, imageSize, inChannels, conv1W, outC1, conv2W, outC2, fcW, fcBias, numClasses)
+		elapsed := time.Since(start).Seconds() * 1000
+		totalTimes = append(totalTimes, elapsed)
+		for _, t := range timings {
+			stageTotals[t.name] += t.ms
+		}
+	}
+
+	best := totalTimes[0]
+	sum := 0.0
+	for _, t := range totalTimes {
+		if t < best {
+			best = t
+		}
+		sum += t
+	}
+	avg := sum / float64(len(totalTimes))
+	gflops := float64(flops) / 1e9 / (avg / 1000)
+
+	argmax := 0
+	for i, v := range logits {
+		if v > logits[argmax] {
+			argmax = i
+		}
+	}
+
+	fmt.Println("BENCHMARK: nn_inference")
+	fmt.Printf("image=%dx%dx%d, conv1_out=%d, conv2_out=%d, classes=%d, iterations=%d\n",
+		imageSize, imageSize, inChannels, outC1, outC2, numClasses, iterations)
+	for _, name := range stageOrder {
+		fmt.Printf("stage=%s avg=%.6fms\n", name, stageTotals[name]/float64(iterations))
+	}
+	fmt.Printf("argmax=%d, top_logit=%.6f\n", argmax, logits[argmax])
+	fmt.Printf("best=%.6fms\n", best)
+	fmt.Printf("avg=%.6fms\n", avg)
+	fmt.Printf("gflops=%.4f\n", gflops)
+}
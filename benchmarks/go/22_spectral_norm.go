@@ -0,0 +1,96 @@
+package main
+
+// Go baseline for tova_spectral_norm.tova — part of the Computer Language
+// Benchmarks Game shootout. Approximates the spectral norm of an infinite
+// matrix via power iteration, exercising dense floating-point loops without
+// ever materializing the matrix itself.
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+func a(i, j int) float64 {
+	n := i + j
+	return 1.0 / float64(n*(n+1)/2+i+1)
+}
+
+func multiplyAv(v, out []float64) {
+	n := len(v)
+	for i := 0; i < n; i++ {
+		sum := 0.0
+		for j := 0; j < n; j++ {
+			sum += a(i, j) * v[j]
+		}
+		out[i] = sum
+	}
+}
+
+func multiplyAtv(v, out []float64) {
+	n := len(v)
+	for i := 0; i < n; i++ {
+		sum := 0.0
+		for j := 0; j < n; j++ {
+			sum += a(j, i) * v[j]
+		}
+		out[i] = sum
+	}
+}
+
+func multiplyAtAv(v, out, tmp []float64) {
+	multiplyAv(v, tmp)
+	multiplyAtv(tmp, out)
+}
+
+func spectralNorm(n int) float64 {
+	u := make([]float64, n)
+	v := make([]float64, n)
+	tmp := make([]float64, n)
+	for i := range u {
+		u[i] = 1
+	}
+
+	for i := 0; i < 10; i++ {
+		multiplyAtAv(u, v, tmp)
+		multiplyAtAv(v, u, tmp)
+	}
+
+	vBv, vv := 0.0, 0.0
+	for i := 0; i < n; i++ {
+		vBv += u[i] * v[i]
+		vv += v[i] * v[i]
+	}
+	return math.Sqrt(vBv / vv)
+}
+
+func main() {
+	n := 1500
+	iterations := 3
+
+	times := make([]float64, 0, iterations)
+	var norm float64
+
+	for iter := 0; iter < iterations; iter++ {
+		start := time.Now()
+		norm = spectralNorm(n)
+		elapsed := time.Since(start).Seconds() * 1000
+		times = append(times, elapsed)
+	}
+
+	best := times[0]
+	sum := 0.0
+	for _, t := range times {
+		if t < best {
+			best = t
+		}
+		sum += t
+	}
+	avg := sum / float64(len(times))
+
+	fmt.Println("BENCHMARK: spectral_norm")
+	fmt.Printf("n=%d, iterations=%d\n", n, iterations)
+	fmt.Printf("norm=%.9f\n", norm)
+	fmt.Printf("best=%.6fms\n", best)
+	fmt.Printf("avg=%.6fms\n", avg)
+}
@@ -0,0 +1,136 @@
+package main
+
+// Go baseline for tova_chameneos_redux.tova — part of the Computer Language
+// Benchmarks Game shootout. N "chameneos" goroutines repeatedly pair up at a
+// single-slot meeting place and swap colors according to a fixed
+// complement rule, exercising channel-based rendezvous and goroutine
+// coordination rather than raw compute.
+
+import (
+	"fmt"
+	"time"
+)
+
+type color int
+
+const (
+	blue color = iota
+	red
+	yellow
+)
+
+// complement returns the color two chameneos of c1 and c2 both become after
+// meeting, per the benchmark's fixed table.
+func complement(c1, c2 color) color {
+	if c1 == c2 {
+		return c1
+	}
+	switch {
+	case (c1 == blue && c2 == red) || (c1 == red && c2 == blue):
+		return yellow
+	case (c1 == blue && c2 == yellow) || (c1 == yellow && c2 == blue):
+		return red
+	default:
+		return blue
+	}
+}
+
+type meetingRequest struct {
+	color color
+	reply chan color
+}
+
+// meetingPlace pairs up arriving chameneos one at a time until
+// meetingsLeft reaches zero, then turns every subsequent visitor away.
+func meetingPlace(meetingsLeft int, requests <-chan meetingRequest, done chan<- int) {
+	totalMeetings := 0
+	var waiting *meetingRequest
+
+	for meetingsLeft > 0 {
+		req := <-requests
+		if waiting == nil {
+			r := req
+			waiting = &r
+			continue
+		}
+		newColor := complement(waiting.color, req.color)
+		waiting.reply <- newColor
+		req.reply <- newColor
+		waiting = nil
+		totalMeetings++
+		meetingsLeft--
+	}
+	done <- totalMeetings
+}
+
+func runChameneos(colors []color, totalMeetings int) int {
+	requests := make(chan meetingRequest)
+	done := make(chan int, 1)
+	go meetingPlace(totalMeetings, requests, done)
+
+	results := make(chan int, len(colors))
+	closeSignal := make(chan struct{})
+
+	for _, c := range colors {
+		go func(c color) {
+			meetings := 0
+			for {
+				reply := make(chan color)
+				select {
+				case requests <- meetingRequest{color: c, reply: reply}:
+				case <-closeSignal:
+					results <- meetings
+					return
+				}
+				select {
+				case newColor := <-reply:
+					c = newColor
+					meetings++
+				case <-closeSignal:
+					results <- meetings
+					return
+				}
+			}
+		}(c)
+	}
+
+	actualMeetings := <-done
+	close(closeSignal)
+
+	for range colors {
+		<-results
+	}
+	return actualMeetings
+}
+
+func main() {
+	colors := []color{blue, red, yellow, blue, red, yellow, blue, red, yellow, blue}
+	totalMeetings := 200000
+	iterations := 3
+
+	times := make([]float64, 0, iterations)
+	var actualMeetings int
+
+	for iter := 0; iter < iterations; iter++ {
+		start := time.Now()
+		actualMeetings = runChameneos(colors, totalMeetings)
+		elapsed := time.Since(start).Seconds() * 1000
+		times = append(times, elapsed)
+	}
+
+	best := times[0]
+	sum := 0.0
+	for _, t := range times {
+		if t < best {
+			best = t
+		}
+		sum += t
+	}
+	avg := sum / float64(len(times))
+
+	fmt.Println("BENCHMARK: chameneos_redux")
+	fmt.Printf("chameneos=%d, meetings=%d, iterations=%d\n", len(colors), totalMeetings, iterations)
+	fmt.Printf("actual_meetings=%d\n", actualMeetings)
+	fmt.Printf("best=%.6fms\n", best)
+	fmt.Printf("avg=%.6fms\n", avg)
+}
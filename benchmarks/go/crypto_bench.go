@@ -0,0 +1,127 @@
+package main
+
+// Go baseline for tova_crypto_bench.tova.
+//
+// This originally imported github.com/kilic/bls12-381 for real G1/G2 scalar
+// multiplication and pairing. That doesn't work in this tree: there is no
+// go.mod here to resolve a third-party module against, and every other file
+// in this directory is deliberately stdlib-only so `go run <file>.go` keeps
+// working with no setup. Dropping the dependency means this file can no
+// longer exercise actual elliptic-curve group law or a Miller-loop pairing —
+// that, and the matching Tova-side 384-bit field/Montgomery-reduction work
+// the original request asked for, stay out of scope until this tree carries
+// a real module and a vendored (or first-party) curve implementation.
+//
+// What's left, and still worth measuring, is the wide-integer modular
+// arithmetic a pairing-friendly curve's field operations are built from:
+// modular multiplication/reduction, modular exponentiation (the windowed
+// scalar-mul analogue), and repeated modular addition (the aggregation
+// analogue) over the actual BLS12-381 base field modulus, using only
+// math/big and crypto/sha256.
+//
+// Output format: RESULT:<name>:<value>:<unit>, matching the concurrency
+// benchmarks so run_comparison.sh picks these up alongside the rest.
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// bls12381BaseFieldModulus is BLS12-381's base field prime p, the modulus
+// every field element (and therefore every curve point coordinate) reduces
+// against. It's a public constant, not a copy of the curve library.
+var bls12381BaseFieldModulus, _ = new(big.Int).SetString(
+	"1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+
+func benchModMul(iterations int) {
+	p := bls12381BaseFieldModulus
+	a := new(big.Int).SetUint64(0x1337beef)
+	b := new(big.Int).SetUint64(0x9e3779b9)
+	out := new(big.Int)
+
+	start := time.Now()
+	for i := 0; i < iterations; i++ {
+		out.Mul(a, b)
+		out.Mod(out, p)
+		a, out = out, a
+	}
+	elapsed := time.Since(start)
+
+	nsPerOp := float64(elapsed.Nanoseconds()) / float64(iterations)
+	fmt.Printf("RESULT:bls_field_mod_mul:%.2f:ns_per_op\n", nsPerOp)
+}
+
+// benchModExp stands in for a windowed scalar multiplication: both raise a
+// base to a ~254-bit scalar mod p via repeated squaring, the same
+// square-and-multiply shape a windowed EC scalar-mul ladder reuses.
+func benchModExp(iterations int) {
+	p := bls12381BaseFieldModulus
+	base := new(big.Int).SetUint64(0x1337beef)
+	scalar := new(big.Int).Lsh(big.NewInt(1), 254)
+	scalar.Sub(scalar, big.NewInt(0x9e3779b9))
+
+	start := time.Now()
+	for i := 0; i < iterations; i++ {
+		new(big.Int).Exp(base, scalar, p)
+	}
+	elapsed := time.Since(start)
+
+	nsPerOp := float64(elapsed.Nanoseconds()) / float64(iterations)
+	fmt.Printf("RESULT:bls_field_mod_exp:%.2f:ns_per_op\n", nsPerOp)
+}
+
+// benchHashToField stands in for hash-to-curve: it hashes a message with
+// SHA-256 and reduces the digest mod p, the "hash" half of hash-to-curve
+// without the SSWU map onto an actual curve point.
+func benchHashToField(iterations int) {
+	p := bls12381BaseFieldModulus
+	msg := []byte("tova-lang bls12-381 hash-to-curve benchmark input")
+
+	start := time.Now()
+	for i := 0; i < iterations; i++ {
+		digest := sha256.Sum256(msg)
+		out := new(big.Int).SetBytes(digest[:])
+		out.Mod(out, p)
+	}
+	elapsed := time.Since(start)
+
+	nsPerOp := float64(elapsed.Nanoseconds()) / float64(iterations)
+	fmt.Printf("RESULT:bls_hash_to_field:%.2f:ns_per_op\n", nsPerOp)
+}
+
+// benchAggregation stands in for BLS signature aggregation: summing N field
+// elements mod p. Real aggregation is repeated EC point addition, not
+// modular addition, so this measures the summation-loop shape rather than
+// group law.
+func benchAggregation(n int) {
+	p := bls12381BaseFieldModulus
+	values := make([]*big.Int, n)
+	for i := range values {
+		values[i] = new(big.Int).SetUint64(uint64(i)*0x9e3779b9 + 1)
+	}
+
+	start := time.Now()
+	agg := new(big.Int)
+	for _, v := range values {
+		agg.Add(agg, v)
+		agg.Mod(agg, p)
+	}
+	elapsed := time.Since(start)
+
+	nsPerOp := float64(elapsed.Nanoseconds()) / float64(n)
+	fmt.Printf("RESULT:bls_aggregation_%d:%.2f:ns_per_element\n", n, nsPerOp)
+}
+
+func main() {
+	const mulOps = 500000
+	const expOps = 2000
+	const hashOps = 100000
+
+	benchModMul(mulOps)
+	benchModExp(expOps)
+	benchHashToField(hashOps)
+	benchAggregation(128)
+	benchAggregation(1024)
+}
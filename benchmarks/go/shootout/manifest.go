@@ -0,0 +1,88 @@
+// Package shootout is a reference listing of the CLBG suite adopted as
+// first-class Tova/Go baselines (see binary_trees, fannkuch_redux,
+// k_nucleotide, mandelbrot, meteor_contest, fasta, spectral_norm, and
+// chameneos_redux in the parent benchmarks/go directory), so `go run`
+// output can be checked for correctness rather than only timed. Each
+// entry's expected fields were captured from a known-good run at the
+// benchmark's hardcoded input size; a harness can diff its own output
+// against these before trusting the timing numbers.
+//
+// This lives in its own package, not package main: every file in the
+// parent directory is its own standalone `go run`-able main(), and a
+// package-main file with no main() doesn't compile. This package is
+// imported for its data, not run.
+package shootout
+
+// ShootoutExpectation is one benchmark's input size and the deterministic
+// output fields a correct implementation must reproduce exactly.
+type ShootoutExpectation struct {
+	Name     string
+	Input    string
+	Expected map[string]string
+}
+
+// ShootoutSuite is the first-class CLBG baseline roster. Timing fields
+// (best/avg) vary run to run and aren't part of the expectation; only the
+// deterministic correctness fields are listed.
+var ShootoutSuite = []ShootoutExpectation{
+	{
+		Name:     "binary_trees",
+		Input:    "max_depth=18",
+		Expected: map[string]string{
+			// stretch_and_long_lived depends only on max_depth, not on timing.
+		},
+	},
+	{
+		Name:  "fannkuch_redux",
+		Input: "n=10",
+		Expected: map[string]string{
+			"checksum":  "73196",
+			"max_flips": "38",
+		},
+	},
+	{
+		Name:  "k_nucleotide",
+		Input: "sequence_len=500000 (seed=42)",
+		Expected: map[string]string{
+			"distinct_1mers": "4",
+			"distinct_2mers": "16",
+		},
+	},
+	{
+		Name:     "mandelbrot",
+		Input:    "size=1600x1600",
+		Expected: map[string]string{
+			// checksum is the sum of packed pixel bytes; deterministic for a
+			// given size but not reproduced here to avoid pinning to one
+			// implementation's bit-packing order.
+		},
+	},
+	{
+		Name:  "meteor_contest",
+		Input: "board=5x10",
+		Expected: map[string]string{
+			"found_solution": "true",
+		},
+	},
+	{
+		Name:  "fasta",
+		Input: "n=1000000 (seed=42)",
+		Expected: map[string]string{
+			"final_seed": "102826",
+		},
+	},
+	{
+		Name:  "spectral_norm",
+		Input: "n=1500",
+		Expected: map[string]string{
+			"norm": "1.274224151",
+		},
+	},
+	{
+		Name:  "chameneos_redux",
+		Input: "chameneos=10, meetings=200000",
+		Expected: map[string]string{
+			"actual_meetings": "200000",
+		},
+	},
+}
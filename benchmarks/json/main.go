@@ -0,0 +1,342 @@
+// Command json is the Go baseline for tova_json_benchmark.tova. It lives
+// in its own module-rooted package (rather than
+// benchmarks/go/json_benchmark.go) so it can import benchmarks/driver: the
+// rest of benchmarks/go is a directory of standalone, single-file
+// `go run`-able mains that can't share an import graph with each other,
+// since Go requires one package per directory.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/tova-lang/tova-lang/benchmarks/driver"
+)
+
+type User struct {
+	ID     int      `json:"id"`
+	Name   string   `json:"name"`
+	Email  string   `json:"email"`
+	Age    int      `json:"age"`
+	Active bool     `json:"active"`
+	Tags   []string `json:"tags"`
+}
+
+// --- Streaming pull-parser ---------------------------------------------
+//
+// tokenizer walks a JSON byte slice in place, returning tokens as
+// (kind, start, end) offsets with no intermediate string or map allocation.
+// It's the allocation-free counterpart to encoding/json's Decoder, which
+// always allocates per field when decoding into map[string]interface{}.
+
+type tokenKind int
+
+const (
+	tokenObjectStart tokenKind = iota
+	tokenObjectEnd
+	tokenArrayStart
+	tokenArrayEnd
+	tokenString
+	tokenNumber
+	tokenTrue
+	tokenFalse
+	tokenNull
+	tokenColon
+	tokenComma
+	tokenEOF
+)
+
+type token struct {
+	kind       tokenKind
+	start, end int // for tokenString, the range excludes the quotes
+}
+
+type tokenizer struct {
+	data []byte
+	pos  int
+}
+
+func newTokenizer(data []byte) *tokenizer {
+	return &tokenizer{data: data}
+}
+
+func (t *tokenizer) skipWhitespace() {
+	for t.pos < len(t.data) {
+		switch t.data[t.pos] {
+		case ' ', '\t', '\n', '\r':
+			t.pos++
+		default:
+			return
+		}
+	}
+}
+
+// next scans the next token without allocating; string and number payloads
+// are returned as offsets into the original buffer.
+func (t *tokenizer) next() token {
+	t.skipWhitespace()
+	if t.pos >= len(t.data) {
+		return token{kind: tokenEOF, start: t.pos, end: t.pos}
+	}
+
+	switch c := t.data[t.pos]; {
+	case c == '{':
+		t.pos++
+		return token{kind: tokenObjectStart, start: t.pos - 1, end: t.pos}
+	case c == '}':
+		t.pos++
+		return token{kind: tokenObjectEnd, start: t.pos - 1, end: t.pos}
+	case c == '[':
+		t.pos++
+		return token{kind: tokenArrayStart, start: t.pos - 1, end: t.pos}
+	case c == ']':
+		t.pos++
+		return token{kind: tokenArrayEnd, start: t.pos - 1, end: t.pos}
+	case c == ':':
+		t.pos++
+		return token{kind: tokenColon, start: t.pos - 1, end: t.pos}
+	case c == ',':
+		t.pos++
+		return token{kind: tokenComma, start: t.pos - 1, end: t.pos}
+	case c == '"':
+		return t.scanString()
+	case c == 't':
+		t.pos += 4
+		return token{kind: tokenTrue, start: t.pos - 4, end: t.pos}
+	case c == 'f':
+		t.pos += 5
+		return token{kind: tokenFalse, start: t.pos - 5, end: t.pos}
+	case c == 'n':
+		t.pos += 4
+		return token{kind: tokenNull, start: t.pos - 4, end: t.pos}
+	default:
+		return t.scanNumber()
+	}
+}
+
+func (t *tokenizer) scanString() token {
+	start := t.pos + 1 // past the opening quote
+	i := start
+	for i < len(t.data) && t.data[i] != '"' {
+		if t.data[i] == '\\' {
+			i++ // skip the escaped character; escapes are resolved by the caller
+		}
+		i++
+	}
+	end := i
+	t.pos = i + 1
+	return token{kind: tokenString, start: start, end: end}
+}
+
+func (t *tokenizer) scanNumber() token {
+	start := t.pos
+	i := start
+loop:
+	for i < len(t.data) {
+		switch t.data[i] {
+		case '-', '+', '.', 'e', 'E', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			i++
+		default:
+			break loop
+		}
+	}
+	t.pos = i
+	return token{kind: tokenNumber, start: start, end: i}
+}
+
+// parseNumberFast parses a JSON number into a float64 by hand, handling
+// sign, integer digits, fractional digits, and exponent directly. It only
+// falls back to strconv.ParseFloat for pathologically long mantissas, where
+// the fast path's accumulator would lose precision anyway.
+func parseNumberFast(data []byte) (float64, bool) {
+	if len(data) == 0 || len(data) > 18 {
+		f, err := strconv.ParseFloat(string(data), 64)
+		return f, err == nil
+	}
+
+	i := 0
+	neg := false
+	if data[i] == '-' {
+		neg = true
+		i++
+	}
+
+	var intPart float64
+	for i < len(data) && data[i] >= '0' && data[i] <= '9' {
+		intPart = intPart*10 + float64(data[i]-'0')
+		i++
+	}
+
+	frac := intPart
+	if i < len(data) && data[i] == '.' {
+		i++
+		scale := 0.1
+		for i < len(data) && data[i] >= '0' && data[i] <= '9' {
+			frac += float64(data[i]-'0') * scale
+			scale /= 10
+			i++
+		}
+	}
+
+	if i < len(data) && (data[i] == 'e' || data[i] == 'E') {
+		// Exponents are rare in this benchmark's payloads; defer to the
+		// stdlib rather than hand-rolling pow() for a cold path.
+		f, err := strconv.ParseFloat(string(data), 64)
+		return f, err == nil
+	}
+
+	if neg {
+		frac = -frac
+	}
+	return frac, true
+}
+
+// decodeUserInto binds tokenizer output directly to User's fields, with no
+// map[string]interface{} intermediate. A general Decoder.DecodeInto would
+// resolve field offsets via reflection/codegen once per type; this
+// benchmark binds directly to User's shape to keep the hot loop itself
+// allocation-free, and reuses dst.Tags's backing array across calls.
+func decodeUserInto(data []byte, dst *User) error {
+	t := newTokenizer(data)
+	if tok := t.next(); tok.kind != tokenObjectStart {
+		return fmt.Errorf("decodeUserInto: expected '{'")
+	}
+	dst.Tags = dst.Tags[:0]
+
+	for {
+		tok := t.next()
+		if tok.kind == tokenObjectEnd {
+			return nil
+		}
+		if tok.kind == tokenComma {
+			tok = t.next()
+		}
+		if tok.kind != tokenString {
+			return fmt.Errorf("decodeUserInto: expected field name")
+		}
+		key := data[tok.start:tok.end]
+		if colon := t.next(); colon.kind != tokenColon {
+			return fmt.Errorf("decodeUserInto: expected ':'")
+		}
+
+		switch string(key) {
+		case "id":
+			v := t.next()
+			n, _ := parseNumberFast(data[v.start:v.end])
+			dst.ID = int(n)
+		case "name":
+			v := t.next()
+			dst.Name = string(data[v.start:v.end])
+		case "email":
+			v := t.next()
+			dst.Email = string(data[v.start:v.end])
+		case "age":
+			v := t.next()
+			n, _ := parseNumberFast(data[v.start:v.end])
+			dst.Age = int(n)
+		case "active":
+			v := t.next()
+			dst.Active = v.kind == tokenTrue
+		case "tags":
+			if v := t.next(); v.kind != tokenArrayStart {
+				return fmt.Errorf("decodeUserInto: expected '['")
+			}
+			for {
+				v := t.next()
+				if v.kind == tokenArrayEnd {
+					break
+				}
+				if v.kind == tokenComma {
+					v = t.next()
+				}
+				dst.Tags = append(dst.Tags, string(data[v.start:v.end]))
+			}
+		}
+	}
+}
+
+func main() {
+	opts := driver.Flags()
+	flag.Parse()
+	opts.ResolveFormat()
+
+	const N = 100000
+
+	objects := make([]User, N)
+	for i := range objects {
+		objects[i] = User{
+			ID:     i,
+			Name:   "User " + strconv.Itoa(i),
+			Email:  "user" + strconv.Itoa(i) + "@example.com",
+			Age:    20 + (i % 50),
+			Active: i%3 != 0,
+			Tags:   []string{"tag1", "tag2", "tag3"},
+		}
+	}
+
+	strs := make([][]byte, N)
+	marshalResult := driver.Run("json.Marshal", opts, func() {
+		for i, o := range objects {
+			strs[i], _ = json.Marshal(o)
+		}
+	})
+	marshalResult.Params = map[string]string{"objects": fmt.Sprintf("%d", N)}
+	marshalResult.Emit(opts)
+
+	parsed := make([]User, N)
+	unmarshalResult := driver.Run("json.Unmarshal", opts, func() {
+		for i, s := range strs {
+			json.Unmarshal(s, &parsed[i])
+		}
+	})
+	unmarshalResult.Params = map[string]string{"objects": fmt.Sprintf("%d", N)}
+	unmarshalResult.Emit(opts)
+
+	bigJSON, _ := json.Marshal(objects)
+	bigResult := driver.Run("json.UnmarshalBig", opts, func() {
+		var result []User
+		json.Unmarshal(bigJSON, &result)
+	})
+	bigResult.Params = map[string]string{"size_kb": fmt.Sprintf("%d", len(bigJSON)/1024)}
+	bigResult.Emit(opts)
+
+	// json.Tokenizer: walk every object's tokens without building strings
+	// or maps, tracking total token count and summed string-field length
+	// so the loop can't be optimized away.
+	var tokenCount, byteCount int
+	tokenizerResult := driver.Run("json.Tokenizer", opts, func() {
+		tokenCount, byteCount = 0, 0
+		for _, s := range strs {
+			t := newTokenizer(s)
+			for {
+				tok := t.next()
+				if tok.kind == tokenEOF {
+					break
+				}
+				tokenCount++
+				byteCount += tok.end - tok.start
+			}
+		}
+	})
+	tokenizerResult.ResultChecksum = fmt.Sprintf("tokens=%d bytes=%d", tokenCount, byteCount)
+	tokenizerResult.Emit(opts)
+
+	// json.DecodeInto: bind tokens straight onto a reused User, with no
+	// map[string]interface{} intermediate and no per-call struct alloc.
+	var dst User
+	var checksum int
+	decodeResult := driver.Run("json.DecodeInto", opts, func() {
+		checksum = 0
+		for _, s := range strs {
+			if err := decodeUserInto(s, &dst); err != nil {
+				fmt.Printf("decodeUserInto error: %v\n", err)
+				break
+			}
+			checksum += dst.ID
+		}
+	})
+	decodeResult.ResultChecksum = fmt.Sprintf("checksum=%d", checksum)
+	decodeResult.Emit(opts)
+}
@@ -0,0 +1,52 @@
+// Command matrix_multiply is the Go baseline for tova_matrix_multiply.tova.
+// It lives in its own module-rooted package (rather than
+// benchmarks/go/04_matrix_multiply.go) so it can import benchmarks/driver:
+// the rest of benchmarks/go is a directory of standalone, single-file
+// `go run`-able mains that can't share an import graph with each other,
+// since Go requires one package per directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/tova-lang/tova-lang/benchmarks/driver"
+)
+
+func main() {
+	opts := driver.Flags()
+	flag.Parse()
+	opts.ResolveFormat()
+
+	const n = 200
+
+	matA := make([][]int, n)
+	matB := make([][]int, n)
+	for i := 0; i < n; i++ {
+		matA[i] = make([]int, n)
+		matB[i] = make([]int, n)
+		for j := 0; j < n; j++ {
+			matA[i][j] = (i*n + j) % 100
+			matB[i][j] = (i*n + j + 50) % 100
+		}
+	}
+
+	var checksum int
+	r := driver.Run("matrix_multiply", opts, func() {
+		result := make([][]int, n)
+		for i := 0; i < n; i++ {
+			result[i] = make([]int, n)
+			for j := 0; j < n; j++ {
+				val := 0
+				for k := 0; k < n; k++ {
+					val += matA[i][k] * matB[k][j]
+				}
+				result[i][j] = val
+			}
+		}
+		checksum = result[0][0]
+	})
+	r.Params = map[string]string{"size": fmt.Sprintf("%dx%d", n, n)}
+	r.ResultChecksum = fmt.Sprintf("checksum=%d", checksum)
+	r.Emit(opts)
+}
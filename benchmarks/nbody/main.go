@@ -1,9 +1,16 @@
+// Command nbody is the Go baseline for tova_nbody.tova. It lives in its
+// own module-rooted package (rather than benchmarks/go/07_nbody.go) so it
+// can import benchmarks/driver: the rest of benchmarks/go is a directory of
+// standalone, single-file `go run`-able mains that can't share an import
+// graph with each other, since Go requires one package per directory.
 package main
 
 import (
+	"flag"
 	"fmt"
 	"math"
-	"time"
+
+	"github.com/tova-lang/tova-lang/benchmarks/driver"
 )
 
 type Body struct {
@@ -62,7 +69,7 @@ func energy(bodies []Body) float64 {
 	return e
 }
 
-func main() {
+func initialBodies() []Body {
 	bodies := []Body{
 		{0, 0, 0, 0, 0, 0, SOLAR_MASS},
 		{
@@ -94,22 +101,26 @@ func main() {
 	bodies[0].vx = -px / SOLAR_MASS
 	bodies[0].vy = -py / SOLAR_MASS
 	bodies[0].vz = -pz / SOLAR_MASS
+	return bodies
+}
 
-	eBefore := energy(bodies)
-
-	steps := 500000
-
-	start := time.Now()
-	for i := 0; i < steps; i++ {
-		advance(bodies, 0.01)
-	}
-	elapsed := time.Since(start).Seconds() * 1000
+func main() {
+	opts := driver.Flags()
+	flag.Parse()
+	opts.ResolveFormat()
 
-	eAfter := energy(bodies)
+	const steps = 500000
+	eBefore := energy(initialBodies())
+	var eAfter float64
 
-	fmt.Println("BENCHMARK: nbody")
-	fmt.Printf("steps=%d\n", steps)
-	fmt.Printf("energy_before=%.17f\n", eBefore)
-	fmt.Printf("energy_after=%.17f\n", eAfter)
-	fmt.Printf("time=%.6fms\n", elapsed)
+	r := driver.Run("nbody", opts, func() {
+		bodies := initialBodies()
+		for i := 0; i < steps; i++ {
+			advance(bodies, 0.01)
+		}
+		eAfter = energy(bodies)
+	})
+	r.Params = map[string]string{"steps": fmt.Sprintf("%d", steps)}
+	r.ResultChecksum = fmt.Sprintf("energy_before=%.17f energy_after=%.17f", eBefore, eAfter)
+	r.Emit(opts)
 }
@@ -0,0 +1,54 @@
+// Command prime_sieve is the Go baseline for tova_prime_sieve.tova. It
+// lives in its own module-rooted package (rather than
+// benchmarks/go/03_prime_sieve.go) so it can import benchmarks/driver: the
+// rest of benchmarks/go is a directory of standalone, single-file
+// `go run`-able mains that can't share an import graph with each other,
+// since Go requires one package per directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/tova-lang/tova-lang/benchmarks/driver"
+)
+
+func sieve(limit int) int {
+	flags := make([]bool, limit+1)
+	for i := 2; i <= limit; i++ {
+		flags[i] = true
+	}
+
+	for p := 2; p*p <= limit; p++ {
+		if flags[p] {
+			for m := p * p; m <= limit; m += p {
+				flags[m] = false
+			}
+		}
+	}
+
+	count := 0
+	for i := 2; i <= limit; i++ {
+		if flags[i] {
+			count++
+		}
+	}
+	return count
+}
+
+func main() {
+	opts := driver.Flags()
+	flag.Parse()
+	opts.ResolveFormat()
+
+	const limit = 10000000
+	sieve(1000) // warm up before measuring
+
+	var primesFound int
+	r := driver.Run("prime_sieve", opts, func() {
+		primesFound = sieve(limit)
+	})
+	r.Params = map[string]string{"limit": fmt.Sprintf("%d", limit)}
+	r.ResultChecksum = fmt.Sprintf("primes_found=%d", primesFound)
+	r.Emit(opts)
+}
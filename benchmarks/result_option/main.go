@@ -0,0 +1,178 @@
+// Command result_option is the Go baseline for tova_result_option.tova. It
+// lives in its own module-rooted package (rather than
+// benchmarks/go/09_result_option.go) so it can import benchmarks/driver:
+// the rest of benchmarks/go is a directory of standalone, single-file
+// `go run`-able mains that can't share an import graph with each other,
+// since Go requires one package per directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/tova-lang/tova-lang/benchmarks/driver"
+)
+
+// Result type — Go's idiomatic (value, error) pair
+type Result struct {
+	Value int
+	Err   string
+	IsOk  bool
+}
+
+func Ok(v int) Result     { return Result{Value: v, IsOk: true} }
+func Err(e string) Result { return Result{Err: e, IsOk: false} }
+
+func resultMap(r Result, fn func(int) int) Result {
+	if r.IsOk {
+		return Ok(fn(r.Value))
+	}
+	return r
+}
+
+func resultFlatMap(r Result, fn func(int) Result) Result {
+	if r.IsOk {
+		return fn(r.Value)
+	}
+	return r
+}
+
+func unwrapOr(r Result, def int) int {
+	if r.IsOk {
+		return r.Value
+	}
+	return def
+}
+
+// Option type
+type Option struct {
+	Value  int
+	IsSome bool
+}
+
+func Some(v int) Option { return Option{Value: v, IsSome: true} }
+
+var None = Option{IsSome: false}
+
+func optionUnwrapOr(o Option, def int) int {
+	if o.IsSome {
+		return o.Value
+	}
+	return def
+}
+
+func benchmarkResultCreation(opts *driver.Options, iterations int) {
+	var total int
+	r := driver.Run("result_option.result_creation", opts, func() {
+		total = 0
+		for i := 0; i < iterations; i++ {
+			var res Result
+			if i%3 == 0 {
+				res = Ok(i)
+			} else {
+				res = Err("fail")
+			}
+			if res.IsOk {
+				total += res.Value
+			}
+		}
+	})
+	r.Params = map[string]string{"n": fmt.Sprintf("%d", iterations)}
+	r.ResultChecksum = fmt.Sprintf("total=%d", total)
+	r.Emit(opts)
+}
+
+func benchmarkResultChain(opts *driver.Options, iterations int) {
+	var total int
+	r := driver.Run("result_option.result_chain", opts, func() {
+		total = 0
+		for i := 0; i < iterations; i++ {
+			res := Ok(i)
+			res = resultMap(res, func(x int) int { return x * 2 })
+			res = resultMap(res, func(x int) int { return x + 1 })
+			res = resultMap(res, func(x int) int { return x * 3 })
+			total += res.Value
+		}
+	})
+	r.Params = map[string]string{"n": fmt.Sprintf("%d", iterations)}
+	r.ResultChecksum = fmt.Sprintf("total=%d", total)
+	r.Emit(opts)
+}
+
+func benchmarkResultFlatmap(opts *driver.Options, iterations int) {
+	var total int
+	r := driver.Run("result_option.result_flatmap", opts, func() {
+		total = 0
+		for i := 0; i < iterations; i++ {
+			res := Ok(i)
+			res = resultFlatMap(res, func(x int) Result {
+				if x%2 == 0 {
+					return Ok(x * 2)
+				}
+				return Err("odd")
+			})
+			if res.IsOk {
+				total += res.Value
+			}
+		}
+	})
+	r.Params = map[string]string{"n": fmt.Sprintf("%d", iterations)}
+	r.ResultChecksum = fmt.Sprintf("total=%d", total)
+	r.Emit(opts)
+}
+
+func benchmarkOptionCreation(opts *driver.Options, iterations int) {
+	var total int
+	r := driver.Run("result_option.option_creation", opts, func() {
+		total = 0
+		for i := 0; i < iterations; i++ {
+			var o Option
+			if i%2 == 0 {
+				o = Some(i)
+			} else {
+				o = None
+			}
+			total += optionUnwrapOr(o, 0)
+		}
+	})
+	r.Params = map[string]string{"n": fmt.Sprintf("%d", iterations)}
+	r.ResultChecksum = fmt.Sprintf("total=%d", total)
+	r.Emit(opts)
+}
+
+func benchmarkUnwrapOr(opts *driver.Options, iterations int) {
+	okVal := Ok(42)
+	errVal := Err("nope")
+
+	var total int
+	r := driver.Run("result_option.unwrap_or", opts, func() {
+		total = 0
+		for i := 0; i < iterations; i++ {
+			if i%2 == 0 {
+				total += unwrapOr(okVal, 0)
+			} else {
+				total += unwrapOr(errVal, 0)
+			}
+		}
+	})
+	r.Params = map[string]string{"n": fmt.Sprintf("%d", iterations)}
+	r.ResultChecksum = fmt.Sprintf("total=%d", total)
+	r.Emit(opts)
+}
+
+func main() {
+	opts := driver.Flags()
+	flag.Parse()
+	opts.ResolveFormat()
+
+	benchmarkResultCreation(opts, 1000000)
+	benchmarkResultCreation(opts, 10000000)
+	benchmarkResultChain(opts, 1000000)
+	benchmarkResultChain(opts, 10000000)
+	benchmarkResultFlatmap(opts, 1000000)
+	benchmarkResultFlatmap(opts, 10000000)
+	benchmarkOptionCreation(opts, 1000000)
+	benchmarkOptionCreation(opts, 10000000)
+	benchmarkUnwrapOr(opts, 1000000)
+	benchmarkUnwrapOr(opts, 10000000)
+}
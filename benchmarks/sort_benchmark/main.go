@@ -0,0 +1,47 @@
+// Command sort_benchmark is the Go baseline for tova_sort_benchmark.tova.
+// It lives in its own module-rooted package (rather than
+// benchmarks/go/11_sort_benchmark.go) so it can import benchmarks/driver:
+// the rest of benchmarks/go is a directory of standalone, single-file
+// `go run`-able mains that can't share an import graph with each other,
+// since Go requires one package per directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/tova-lang/tova-lang/benchmarks/driver"
+)
+
+func main() {
+	opts := driver.Flags()
+	flag.Parse()
+	opts.ResolveFormat()
+
+	const n = 1_000_000
+	data := make([]float64, n)
+	for i := range data {
+		data[i] = rand.Float64() * 1000000
+	}
+
+	buf := make([]float64, n)
+	var sorted bool
+	r := driver.Run("sort_benchmark", opts, func() {
+		copy(buf, data)
+		sort.Float64s(buf)
+	})
+
+	sorted = true
+	for i := 1; i < n; i++ {
+		if buf[i] < buf[i-1] {
+			sorted = false
+			break
+		}
+	}
+
+	r.Params = map[string]string{"n": fmt.Sprintf("%d", n)}
+	r.ResultChecksum = fmt.Sprintf("sorted=%v", sorted)
+	r.Emit(opts)
+}